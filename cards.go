@@ -1,6 +1,9 @@
 package holdem
 
-import "math/rand"
+import (
+	"math"
+	"math/rand"
+)
 
 type Hand uint64
 type Card uint8
@@ -113,6 +116,157 @@ func _compare(h1 Hand, l1 uint8, h2 Hand, l2 uint8, firstCard Card, lookahead ui
 	return 0
 }
 
+// Equity estimates each hand's probability of winning at showdown
+// using Monte Carlo simulation. Compare's exhaustive recursion is
+// only practical for two hands; for 3 or more hands, or when few
+// community cards are known, the number of possible completions
+// explodes and Equity is the tractable alternative. board is the
+// community cards already known, and lookahead is the total number
+// of cards each hand will have once the board is complete, exactly
+// as in Compare (7 in Texas Hold'em: 2 hole cards plus a 5 card
+// board). Each of the `iterations` runs deals random cards from the
+// undealt portion of the deck to complete the board, scores every
+// hand's best 5 cards via BestFive, and credits the winner - split
+// evenly among any tied hands. The result is each hand's share of the
+// iterations, summing to 1.
+func Equity(hands []Hand, board Hand, lookahead uint8, iterations int) []float64 {
+	wins := make([]float64, len(hands))
+
+	dealt := board
+	for _, h := range hands {
+		dealt = dealt.Combine(h)
+	}
+	toDeal := boardCardsToDeal(hands, board, lookahead)
+
+	for i := 0; i < iterations; i++ {
+		creditWinners(hands, dealBoard(board, dealt, toDeal), wins)
+	}
+
+	for i := range wins {
+		wins[i] /= float64(iterations)
+	}
+	return wins
+}
+
+// boardCardsToDeal returns the number of further community cards
+// needed to bring every hand up to lookahead total cards, assuming
+// all hands currently hold the same number of cards.
+func boardCardsToDeal(hands []Hand, board Hand, lookahead uint8) int {
+	if len(hands) == 0 {
+		return int(lookahead) - int(board.NumCards())
+	}
+	return int(lookahead) - int(board.NumCards()) - int(hands[0].NumCards())
+}
+
+// EquityUntil behaves like Equity, but stops early once every hand's
+// 95% Wilson confidence interval for its win probability has width at
+// most epsilon, or once maxIterations is reached, whichever comes
+// first. This trades a little precision for a lot less work once the
+// estimate is already tight enough to act on.
+func EquityUntil(hands []Hand, board Hand, lookahead uint8, epsilon float64, maxIterations int) []float64 {
+	wins := make([]float64, len(hands))
+
+	dealt := board
+	for _, h := range hands {
+		dealt = dealt.Combine(h)
+	}
+	toDeal := boardCardsToDeal(hands, board, lookahead)
+
+	n := 0
+	for n < maxIterations {
+		creditWinners(hands, dealBoard(board, dealt, toDeal), wins)
+		n++
+
+		if n%100 == 0 && wilsonConverged(wins, n, epsilon) {
+			break
+		}
+	}
+
+	result := make([]float64, len(wins))
+	for i, w := range wins {
+		result[i] = w / float64(n)
+	}
+	return result
+}
+
+// dealBoard returns board with toDeal random undealt cards added,
+// never drawing a card already present in dealt.
+func dealBoard(board Hand, dealt Hand, toDeal int) Hand {
+	final := board
+	for d := 0; d < toDeal; d++ {
+		c := randomUndealtCard(dealt)
+		final = final.AddCard(c)
+		dealt = dealt.AddCard(c)
+	}
+	return final
+}
+
+// randomUndealtCard returns a psuedorandomly selected card that is
+// not already present in dealt.
+func randomUndealtCard(dealt Hand) Card {
+	for {
+		c := Card(rand.Int31n(NUM_CARDS))
+		if !dealt.HasCard(c) {
+			return c
+		}
+	}
+}
+
+// creditWinners scores each hand's best 5 cards combined with board
+// and adds 1, split evenly among ties, to the winner(s)' entry in
+// wins.
+func creditWinners(hands []Hand, board Hand, wins []float64) {
+	scores := make([]uint64, len(hands))
+	best := uint64(0)
+	for i, h := range hands {
+		_, s := h.Combine(board).BestFive()
+		scores[i] = s
+		if s > best {
+			best = s
+		}
+	}
+
+	winners := 0
+	for _, s := range scores {
+		if s == best {
+			winners++
+		}
+	}
+
+	share := 1.0 / float64(winners)
+	for i, s := range scores {
+		if s == best {
+			wins[i] += share
+		}
+	}
+}
+
+// wilsonConverged reports whether every hand's 95% Wilson confidence
+// interval, given wins[i] observed wins out of n trials, has width at
+// most epsilon.
+func wilsonConverged(wins []float64, n int, epsilon float64) bool {
+	for _, w := range wins {
+		if wilsonWidth(w, n) > epsilon {
+			return false
+		}
+	}
+	return true
+}
+
+// wilsonWidth returns the width of the 95% Wilson score interval for
+// wins successes out of n binomial trials.
+func wilsonWidth(wins float64, n int) float64 {
+	const z = 1.96
+	p := wins / float64(n)
+	nf := float64(n)
+	denom := 1 + z*z/nf
+	center := p + z*z/(2*nf)
+	margin := z * math.Sqrt(p*(1-p)/nf+z*z/(4*nf*nf))
+	lo := (center - margin) / denom
+	hi := (center + margin) / denom
+	return hi - lo
+}
+
 // Describe provides an english description of a hand, such as 'two pairs'
 func (h Hand) Describe() string {
 
@@ -140,53 +294,209 @@ func (h Hand) Describe() string {
 
 // Score returns a number representing the rank of a hand among
 // all possible hands. If h1.Score() > h2.Score() then h1 beats
-// h2. Currently tie breaking for equivalent hands is not handled,
-// for example, ðŸ‚¡ðŸ‚±ðŸƒ‚ðŸƒ’ðŸƒ“ scores the same as ðŸ‚¡ðŸ‚±ðŸƒ‚ðŸƒ’ðŸƒ”
-func (h Hand) Score() uint32 {
-	s := straightFlushScore(h)
-	if s == 0 {
-		fc := FaceCounts(h)
-		s = fourKindScore(fc)
-		if s == 0 {
-			s = fullHouseScore(fc)
-			if s == 0 {
-				s = flushScore(h)
-				if s == 0 {
-					s = straightScore(h)
-					if s == 0 {
-						s = threeKindScore(fc)
-						if s == 0 {
-							s = twoPairScore(fc)
-							if s == 0 {
-								s = pairScore(fc)
-								if s == 0 {
-									return uint32(highCardScore(fc))
-								} else {
-									return uint32(s) + 13
-								}
-							} else {
-								return uint32(s) + (2 * 13)
-							}
-						} else {
-							return uint32(s) + (3 * 13)
-						}
-					} else {
-						return uint32(s) + (4 * 13)
-					}
-				} else {
-					return uint32(s) + (5 * 13)
-				}
-			} else {
-				return uint32(s) + (6 * 13)
+// h2, and if h1.Score() == h2.Score() the hands are tied. The score
+// is a lexicographic tuple packed into a uint64: the hand category
+// occupies the highest order digit, followed by the primary rank(s)
+// of that category and then any kickers, each in descending order of
+// significance. This means ties within a category, for example two
+// pairs of the same rank with a different kicker, are resolved
+// correctly by a plain numeric comparison of the two scores.
+func (h Hand) Score() uint64 {
+	fc := FaceCounts(h)
+
+	if sf := straightFlushScore(h); sf != 0 {
+		return packScore(8, sf-1)
+	}
+	if quad := fourKindScore(fc); quad != 0 {
+		quadFace := quad - 1
+		return packScore(7, append([]Face{quadFace}, topFaces(fc, 1, quadFace)...)...)
+	}
+	if trips, pair, ok := fullHouseFaces(fc); ok {
+		return packScore(6, trips, pair)
+	}
+	if suit, ok := bestFlushSuit(h); ok {
+		return packScore(5, flushFaces(h, suit, 5)...)
+	}
+	if high := straightScore(h); high != 0 {
+		return packScore(4, high-1)
+	}
+	if trips := topFaces(fc3(fc), 1); len(trips) == 1 {
+		tripFace := trips[0]
+		return packScore(3, append([]Face{tripFace}, topFaces(fc, 2, tripFace)...)...)
+	}
+	if hi, lo, ok := twoPairFaces(fc); ok {
+		return packScore(2, append([]Face{hi, lo}, topFaces(fc, 1, hi, lo)...)...)
+	}
+	if pr := pairScore(fc); pr != 0 {
+		pairFace := pr - 1
+		return packScore(1, append([]Face{pairFace}, topFaces(fc, 3, pairFace)...)...)
+	}
+
+	return packScore(0, topFaces(fc, 5)...)
+}
+
+// LowScore returns the ace-to-five lowball score of h, for use in
+// hi/lo split-pot games such as Omaha Hi/Lo and Stud Hi/Lo. Aces
+// count low, straights and flushes are ignored, and the hand
+// qualifies only if it contains five distinct ranks of 8 or below. If
+// h has more than 5 qualifying ranks, as it will when evaluating a
+// 6 or 7 card hand, the 5 lowest are used.
+//
+// The returned score packs those five ranks high to low, so unlike
+// Score, a *lower* LowScore is a better low hand: 5-4-3-2-A, the nut
+// low, packs to the lowest possible value.
+func LowScore(h Hand) (score uint32, qualifies bool) {
+	fc := FaceCounts(h)
+
+	// lowQualifying lists the 8 ranks that count towards a low hand,
+	// in ascending ace-low order: ace, then 2 through 8.
+	lowQualifying := []Face{A, C2, C3, C4, C5, C6, C7, C8}
+
+	present := make([]Face, 0, len(lowQualifying))
+	for _, f := range lowQualifying {
+		if fc[f] > 0 {
+			present = append(present, f)
+		}
+	}
+	if len(present) < 5 {
+		return 0, false
+	}
+	best := present[:5]
+
+	shift := 16
+	for i := len(best) - 1; i >= 0; i-- {
+		score |= uint32(lowRank(best[i])) << uint(shift)
+		shift -= 4
+	}
+	return score, true
+}
+
+// lowRank returns the ace-to-five lowball rank of a qualifying face:
+// ace is 1, 2 is 2, and so on up to 8.
+func lowRank(f Face) uint8 {
+	if f == A {
+		return 1
+	}
+	return uint8(f) + 2
+}
+
+// packScore packs a hand category and up to five ordered ranks, most
+// significant first, into a single uint64 so that hands compare
+// correctly with a plain numeric comparison. Ranks are stored as
+// face+1 so that an absent kicker (zero) always sorts lowest.
+func packScore(category uint8, faces ...Face) uint64 {
+	score := uint64(category) << 20
+	shift := 16
+	for i := 0; i < 5; i++ {
+		var v uint64
+		if i < len(faces) {
+			v = uint64(faces[i]) + 1
+		}
+		score |= v << uint(shift)
+		shift -= 4
+	}
+	return score
+}
+
+// topFaces returns the n highest faces present in fc, excluding any
+// face listed in exclude, in descending order.
+func topFaces(fc []uint8, n int, exclude ...Face) []Face {
+	faces := make([]Face, 0, n)
+	for f := Face(12); len(faces) < n; f-- {
+		excluded := false
+		for _, e := range exclude {
+			if e == f {
+				excluded = true
+				break
 			}
-		} else {
-			return uint32(s) + (7 * 13)
 		}
-	} else {
-		return uint32(s) + (8 * 13)
+		if fc[f] > 0 && !excluded {
+			faces = append(faces, f)
+		}
+		if f == 0 {
+			break
+		}
 	}
+	return faces
+}
 
-	return 0
+// fullHouseFaces returns the trips face and the pair face of the best
+// full house in fc, if any. A second trips is treated as a pair, as
+// is standard when more than 5 cards are available.
+func fullHouseFaces(fc []uint8) (trips Face, pair Face, ok bool) {
+	tripsFaces := topFaces(fc3(fc), 13)
+	if len(tripsFaces) == 0 {
+		return 0, 0, false
+	}
+	trips = tripsFaces[0]
+	pairFaces := topFaces(fc2(fc), 13, trips)
+	if len(pairFaces) == 0 {
+		return 0, 0, false
+	}
+	return trips, pairFaces[0], true
+}
+
+// twoPairFaces returns the high and low pair faces of the best two
+// pair in fc, if any.
+func twoPairFaces(fc []uint8) (hi Face, lo Face, ok bool) {
+	pairs := topFaces(fc2(fc), 13)
+	if len(pairs) < 2 {
+		return 0, 0, false
+	}
+	return pairs[0], pairs[1], true
+}
+
+// fc3 returns a copy of fc with only the faces that occur 3 or more
+// times retained, for use with topFaces.
+func fc3(fc []uint8) []uint8 {
+	return fcAtLeast(fc, 3)
+}
+
+// fc2 returns a copy of fc with only the faces that occur 2 or more
+// times retained, for use with topFaces.
+func fc2(fc []uint8) []uint8 {
+	return fcAtLeast(fc, 2)
+}
+
+func fcAtLeast(fc []uint8, min uint8) []uint8 {
+	result := make([]uint8, len(fc))
+	for f, c := range fc {
+		if c >= min {
+			result[f] = 1
+		}
+	}
+	return result
+}
+
+// bestFlushSuit returns the suit with 5 or more cards in h, if any.
+func bestFlushSuit(h Hand) (Suit, bool) {
+	counts := make([]uint8, 4)
+	for c := Card(0); c < NUM_CARDS; c++ {
+		if h.HasCard(c) {
+			counts[c.Suit()]++
+		}
+	}
+	for s, count := range counts {
+		if count >= 5 {
+			return Suit(s), true
+		}
+	}
+	return 0, false
+}
+
+// flushFaces returns the n highest faces of suit s present in h, in
+// descending order.
+func flushFaces(h Hand, s Suit, n int) []Face {
+	faces := make([]Face, 0, n)
+	for f := Face(12); len(faces) < n; f-- {
+		if h.HasCard(ToCard(f, s)) {
+			faces = append(faces, f)
+		}
+		if f == 0 {
+			break
+		}
+	}
+	return faces
 }
 
 func straightFlushScore(h Hand) Face {
@@ -202,6 +512,12 @@ func straightFlushScore(h Hand) Face {
 				runLength = 0
 			}
 		}
+
+		// A-2-3-4-5, the ace-low wheel, isn't adjacent to 2-3-4-5 in
+		// face order so the run above never finds it.
+		if h.HasCard(ToCard(A, s)) && h.HasCard(ToCard(C2, s)) && h.HasCard(ToCard(C3, s)) && h.HasCard(ToCard(C4, s)) && h.HasCard(ToCard(C5, s)) {
+			return C5 + 1
+		}
 	}
 	return 0
 }
@@ -251,9 +567,27 @@ func straightScore(h Hand) Face {
 			max = 0
 		}
 	}
+
+	// A-2-3-4-5, the ace-low wheel, isn't adjacent to 2-3-4-5 in face
+	// order so the run above never finds it. It's the weakest
+	// straight, ranked as if its high card were the 5.
+	if hasFace(h, A) && hasFace(h, C2) && hasFace(h, C3) && hasFace(h, C4) && hasFace(h, C5) {
+		return C5 + 1
+	}
+
 	return 0
 }
 
+// hasFace reports whether h contains a card of face f, in any suit.
+func hasFace(h Hand, f Face) bool {
+	for s := Suit(0); s < 4; s++ {
+		if h.HasCard(ToCard(f, s)) {
+			return true
+		}
+	}
+	return false
+}
+
 func fourKindScore(fc []uint8) Face {
 	for f := Face(12); f <= 12; f-- {
 		if fc[f] == 4 {
@@ -392,6 +726,60 @@ func (h1 Hand) Combine(h2 Hand) Hand {
 	return h1 | h2
 }
 
+// Cards returns the individual cards present in the hand.
+func (h Hand) Cards() []Card {
+	cards := make([]Card, 0, h.NumCards())
+	for c := Card(0); c < NUM_CARDS; c++ {
+		if h.HasCard(c) {
+			cards = append(cards, c)
+		}
+	}
+	return cards
+}
+
+// BestFive returns the highest scoring 5-card subhand of h along with
+// its score. h must contain at least 5 cards; if it contains exactly
+// 5, h itself is returned. This is the primitive needed to resolve a
+// showdown, where a player's hand is the best 5 cards they can make
+// from their hole cards and the board.
+func (h Hand) BestFive() (best Hand, score uint64) {
+	cards := h.Cards()
+	n := len(cards)
+	if n <= 5 {
+		return h, h.Score()
+	}
+
+	// Gosper's hack: enumerate every 5-bit subset of an n-bit mask in
+	// increasing order, each bit selecting one of the candidate cards.
+	first := true
+	for mask := uint64(1)<<5 - 1; mask < uint64(1)<<uint(n); {
+		sub := Hand(0)
+		for i := 0; i < n; i++ {
+			if mask&(1<<uint(i)) != 0 {
+				sub = sub.AddCard(cards[i])
+			}
+		}
+		if s := sub.Score(); first || s > score {
+			best = sub
+			score = s
+			first = false
+		}
+
+		lowBit := mask & -mask
+		next := mask + lowBit
+		mask = next + (((next ^ mask) / lowBit) >> 2)
+	}
+
+	return best, score
+}
+
+// BestFiveDescribe returns an english description of the best 5-card
+// subhand of h, such as 'two pairs'.
+func (h Hand) BestFiveDescribe() string {
+	best, _ := h.BestFive()
+	return best.Describe()
+}
+
 // RandomHand returns a hand of the specified size
 // comprising psuedorandomly selected cards.
 func RandomHand(size uint8) Hand {
@@ -431,5 +819,5 @@ func (c Card) String() string {
 
 	ss := c.Suit()
 
-	return string('ðŸ‚¡'+(uint(fs)*0x1)+(uint(ss)*0x10)) + " "
+	return string(rune(0x1F0A1+uint(fs)*0x1+uint(ss)*0x10)) + " "
 }
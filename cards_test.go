@@ -0,0 +1,223 @@
+package holdem
+
+import (
+	"math"
+	"testing"
+)
+
+// c parses a handful of cards from face/suit pairs for readability in
+// table tests, e.g. c(A, S, A, D, C2, H) builds A♠A♦2♥.
+func c(args ...interface{}) Hand {
+	h := Hand(0)
+	for i := 0; i < len(args); i += 2 {
+		h = h.AddCard(ToCard(args[i].(Face), args[i+1].(Suit)))
+	}
+	return h
+}
+
+func TestScoreKickers(t *testing.T) {
+	tests := []struct {
+		name   string
+		better Hand
+		worse  Hand
+	}{
+		{
+			"high card, better kicker",
+			c(A, S, K, D, C5, H, C3, C, C4, S),
+			c(A, S, K, D, C5, H, C3, C, C2, S),
+		},
+		{
+			"pair, better kicker",
+			c(A, S, A, D, K, H, Q, C, C4, S),
+			c(A, S, A, D, K, H, Q, C, C3, S),
+		},
+		{
+			"two pair, better high pair",
+			c(K, S, K, D, C2, H, C2, C, A, S),
+			c(Q, S, Q, D, C2, H, C2, C, A, S),
+		},
+		{
+			"two pair, same pairs, better kicker",
+			c(K, S, K, D, C2, H, C2, C, A, S),
+			c(K, S, K, D, C2, H, C2, C, Q, S),
+		},
+		{
+			"three of a kind, better kicker",
+			c(C7, S, C7, D, C7, H, K, C, C4, S),
+			c(C7, S, C7, D, C7, H, K, C, C3, S),
+		},
+		{
+			"four of a kind, better kicker",
+			c(C9, S, C9, D, C9, H, C9, C, A, S),
+			c(C9, S, C9, D, C9, H, C9, C, K, S),
+		},
+		{
+			"full house, better pair",
+			c(C8, S, C8, D, C8, H, K, C, K, S),
+			c(C8, S, C8, D, C8, H, Q, C, Q, S),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.better.Score() <= tt.worse.Score() {
+				t.Errorf("expected %s to score higher than %s", tt.better, tt.worse)
+			}
+		})
+	}
+}
+
+func TestScoreEqualHands(t *testing.T) {
+	h1 := c(A, S, A, D, C2, H, C3, C, C4, S)
+	h2 := c(A, H, A, C, C2, D, C3, S, C4, H)
+
+	if h1.Score() != h2.Score() {
+		t.Errorf("expected equivalent hands to score equally, got %d and %d", h1.Score(), h2.Score())
+	}
+}
+
+func TestBestFiveFromSeven(t *testing.T) {
+	// The pocket pair trips up with a third 9 on the board, and a
+	// pair of kings completes a full house as the best 5-card hand.
+	hole := c(C9, S, C9, H)
+	board := c(C9, D, K, C, K, D, C4, H, C7, S)
+	seven := hole.Combine(board)
+
+	best, score := seven.BestFive()
+
+	if best.NumCards() != 5 {
+		t.Fatalf("expected 5 cards, got %d", best.NumCards())
+	}
+	if best.Describe() != "full house" {
+		t.Errorf("expected full house, got %s", best.Describe())
+	}
+	if score != best.Score() {
+		t.Errorf("expected returned score to match best.Score()")
+	}
+	if seven.BestFiveDescribe() != "full house" {
+		t.Errorf("expected BestFiveDescribe to report full house, got %s", seven.BestFiveDescribe())
+	}
+}
+
+func TestLowScoreQualifies(t *testing.T) {
+	nutLow := c(A, S, C2, D, C3, H, C4, C, C5, S)
+	if _, ok := LowScore(nutLow); !ok {
+		t.Fatal("expected A-2-3-4-5 to qualify for low")
+	}
+
+	higherLow := c(A, S, C2, D, C3, H, C4, C, C6, S)
+	nutLowScore, _ := LowScore(nutLow)
+	higherLowScore, ok := LowScore(higherLow)
+	if !ok {
+		t.Fatal("expected A-2-3-4-6 to qualify for low")
+	}
+	if higherLowScore <= nutLowScore {
+		t.Errorf("expected A-2-3-4-6 to score worse than the nut low A-2-3-4-5")
+	}
+}
+
+func TestLowScoreDoesNotQualify(t *testing.T) {
+	noLow := c(A, S, K, D, Q, H, J, C, C9, S)
+	if _, ok := LowScore(noLow); ok {
+		t.Error("expected a hand with no low ranks to not qualify")
+	}
+
+	pairedLow := c(A, S, A, D, C2, H, C3, C, C4, S)
+	if _, ok := LowScore(pairedLow); ok {
+		t.Error("expected a hand with only 4 distinct low ranks to not qualify")
+	}
+}
+
+func TestLowScorePicksBestFiveFromSeven(t *testing.T) {
+	h := c(A, S, C2, D, C3, H, C4, C, C5, S, C9, H, K, D)
+
+	score, ok := LowScore(h)
+	if !ok {
+		t.Fatal("expected hand to qualify for low")
+	}
+
+	nutLow := c(A, S, C2, D, C3, H, C4, C, C5, S)
+	nutLowScore, _ := LowScore(nutLow)
+	if score != nutLowScore {
+		t.Errorf("expected the best 5 low cards to be picked out of 7, got %d want %d", score, nutLowScore)
+	}
+}
+
+func TestWheelStraight(t *testing.T) {
+	const straightCategory = 4
+
+	wheel := c(A, S, C2, D, C3, H, C4, C, C5, S)
+	sixHigh := c(C2, S, C3, D, C4, H, C5, C, C6, S)
+
+	if category := wheel.Score() >> 20; category != straightCategory {
+		t.Errorf("expected A-2-3-4-5 to score as a straight (category %d), got category %d", straightCategory, category)
+	}
+	if wheel.Score() >= sixHigh.Score() {
+		t.Error("expected the wheel to be the weakest straight, below 2-3-4-5-6")
+	}
+}
+
+func TestWheelStraightFlush(t *testing.T) {
+	const straightFlushCategory = 8
+
+	wheel := c(A, S, C2, S, C3, S, C4, S, C5, S)
+
+	if category := wheel.Score() >> 20; category != straightFlushCategory {
+		t.Errorf("expected A-2-3-4-5 suited to score as a straight flush (category %d), got category %d", straightFlushCategory, category)
+	}
+}
+
+func TestEquityDominantHandWinsMost(t *testing.T) {
+	// Pocket aces against pocket deuces, no board yet: aces should
+	// win comfortably more often than not over many iterations.
+	aces := c(A, S, A, D)
+	deuces := c(C2, H, C2, C)
+
+	equity := Equity([]Hand{aces, deuces}, Hand(0), 7, 2000)
+
+	if len(equity) != 2 {
+		t.Fatalf("expected one equity value per hand, got %d", len(equity))
+	}
+	if equity[0] < 0.75 {
+		t.Errorf("expected pocket aces to win at least 75%% of the time, got %.2f", equity[0])
+	}
+	total := equity[0] + equity[1]
+	if total < 0.99 || total > 1.01 {
+		t.Errorf("expected equities to sum to ~1, got %.4f", total)
+	}
+}
+
+func TestEquityIdenticalHandsSplitEvenly(t *testing.T) {
+	h1 := c(A, S, K, D)
+	h2 := c(A, H, K, C)
+
+	equity := Equity([]Hand{h1, h2}, Hand(0), 7, 2000)
+
+	if math.Abs(equity[0]-equity[1]) > 0.1 {
+		t.Errorf("expected two equivalent hands to split equity evenly, got %.2f and %.2f", equity[0], equity[1])
+	}
+}
+
+func TestEquityUntilConverges(t *testing.T) {
+	aces := c(A, S, A, D)
+	deuces := c(C2, H, C2, C)
+
+	equity := EquityUntil([]Hand{aces, deuces}, Hand(0), 7, 0.05, 20000)
+
+	if equity[0] < 0.7 {
+		t.Errorf("expected pocket aces to be well ahead, got %.2f", equity[0])
+	}
+}
+
+func TestBestFiveWithExactlyFive(t *testing.T) {
+	h := c(A, S, K, D, Q, H, J, C, C10, S)
+
+	best, score := h.BestFive()
+
+	if best != h {
+		t.Errorf("expected BestFive to return the hand unchanged when it has 5 cards")
+	}
+	if score != h.Score() {
+		t.Errorf("expected score to match h.Score()")
+	}
+}
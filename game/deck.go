@@ -0,0 +1,72 @@
+package game
+
+import (
+	"math/rand"
+
+	"github.com/felixwatts/holdem"
+)
+
+// Deck is a shuffled sequence of cards that can be dealt to players
+// one Hand at a time.
+type Deck struct {
+	cards []holdem.Card
+}
+
+// NewDeck returns a full 52 card deck, shuffled deterministically
+// from seed.
+func NewDeck(seed int64) *Deck {
+	return newDeck(seed, func(holdem.Face) bool { return true })
+}
+
+// NewShortDeck returns a 36 card deck with the 2s through 5s removed,
+// shuffled deterministically from seed, for use with ShortDeck.
+func NewShortDeck(seed int64) *Deck {
+	return newDeck(seed, func(f holdem.Face) bool {
+		return f < holdem.C2 || f > holdem.C5
+	})
+}
+
+func newDeck(seed int64, include func(holdem.Face) bool) *Deck {
+	rng := rand.New(rand.NewSource(seed))
+
+	cards := make([]holdem.Card, 0, holdem.NUM_CARDS)
+	for c := holdem.Card(0); c < holdem.NUM_CARDS; c++ {
+		if include(c.Face()) {
+			cards = append(cards, c)
+		}
+	}
+	rng.Shuffle(len(cards), func(i, j int) {
+		cards[i], cards[j] = cards[j], cards[i]
+	})
+
+	return &Deck{cards: cards}
+}
+
+// Deal removes the top n cards from the deck and returns them as a
+// Hand. It panics if fewer than n cards remain.
+func (d *Deck) Deal(n int) holdem.Hand {
+	if n > len(d.cards) {
+		panic("game: not enough cards left in deck")
+	}
+
+	h := holdem.Hand(0)
+	for _, c := range d.cards[:n] {
+		h = h.AddCard(c)
+	}
+	d.cards = d.cards[n:]
+	return h
+}
+
+// DealPlayers deals holeCards to each of n players in turn.
+func (d *Deck) DealPlayers(n int, holeCards int) []holdem.Hand {
+	hands := make([]holdem.Hand, n)
+	for i := range hands {
+		hands[i] = d.Deal(holeCards)
+	}
+	return hands
+}
+
+// Remaining returns the number of cards left in the deck.
+func (d *Deck) Remaining() int {
+	return len(d.cards)
+}
@@ -0,0 +1,172 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/felixwatts/holdem"
+)
+
+func TestDeckDealIsDisjoint(t *testing.T) {
+	d := NewDeck(1)
+
+	players := d.DealPlayers(4, TexasHoldem{}.HoleCards())
+	board := d.Deal(TexasHoldem{}.BoardCards())
+
+	seen := holdem.Hand(0)
+	for _, p := range players {
+		for _, c := range p.Cards() {
+			if seen.HasCard(c) {
+				t.Fatalf("card %s dealt more than once", c)
+			}
+		}
+		seen = seen.Combine(p)
+	}
+	seen = seen.Combine(board)
+
+	if seen.NumCards() != 4*2+5 {
+		t.Fatalf("expected %d unique cards dealt, got %d", 4*2+5, seen.NumCards())
+	}
+	if d.Remaining() != holdem.NUM_CARDS-int(seen.NumCards()) {
+		t.Fatalf("expected %d cards remaining, got %d", holdem.NUM_CARDS-int(seen.NumCards()), d.Remaining())
+	}
+}
+
+func TestShowdownTexasHoldemSplitPot(t *testing.T) {
+	board := holdem.CreateHand(
+		holdem.ToCard(holdem.A, holdem.S), holdem.ToCard(holdem.K, holdem.S),
+		holdem.ToCard(holdem.Q, holdem.S), holdem.ToCard(holdem.J, holdem.S),
+		holdem.ToCard(holdem.C10, holdem.S),
+	)
+	// Both players' hole cards are irrelevant: the board already
+	// plays a royal flush, so the pot should split.
+	player1 := holdem.CreateHand(holdem.ToCard(holdem.C2, holdem.H), holdem.ToCard(holdem.C4, holdem.H))
+	player2 := holdem.CreateHand(holdem.ToCard(holdem.C3, holdem.D), holdem.ToCard(holdem.C5, holdem.D))
+
+	winners := Showdown([]holdem.Hand{player1, player2}, board, TexasHoldem{})
+
+	if len(winners) != 2 {
+		t.Fatalf("expected a split pot between both players, got winners %v", winners)
+	}
+}
+
+func TestOmahaMustUseExactlyTwoHoleCards(t *testing.T) {
+	// The board alone is a straight flush, but Omaha forbids playing
+	// the board: the player must use exactly 2 of their hole cards,
+	// so their actual best hand is much weaker.
+	board := holdem.CreateHand(
+		holdem.ToCard(holdem.C9, holdem.S), holdem.ToCard(holdem.C8, holdem.S),
+		holdem.ToCard(holdem.C7, holdem.S), holdem.ToCard(holdem.C6, holdem.S),
+		holdem.ToCard(holdem.C5, holdem.S),
+	)
+	hole := holdem.CreateHand(
+		holdem.ToCard(holdem.C2, holdem.H), holdem.ToCard(holdem.C2, holdem.D),
+		holdem.ToCard(holdem.C3, holdem.H), holdem.ToCard(holdem.C4, holdem.D),
+	)
+
+	score := Omaha{}.Evaluate(hole, board)
+	_, boardPlayedScore := hole.Combine(board).BestFive()
+
+	if score >= boardPlayedScore {
+		t.Errorf("expected Omaha score to be weaker than playing the board outright")
+	}
+}
+
+func TestOmahaEvaluateLowRespectsTwoPlusThree(t *testing.T) {
+	// The board alone (5, K, Q, J, 9) has no low. All 4 hole cards are
+	// A-2-3-4, so only LowScore on the full 7 cards would find the
+	// nut low A-2-3-4-5 - but Omaha forbids using more than 2 hole
+	// cards, so no legal 5-card hand here actually qualifies.
+	board := holdem.CreateHand(
+		holdem.ToCard(holdem.C5, holdem.S), holdem.ToCard(holdem.K, holdem.D),
+		holdem.ToCard(holdem.Q, holdem.C), holdem.ToCard(holdem.J, holdem.H),
+		holdem.ToCard(holdem.C9, holdem.D),
+	)
+	hole := holdem.CreateHand(
+		holdem.ToCard(holdem.A, holdem.S), holdem.ToCard(holdem.C2, holdem.H),
+		holdem.ToCard(holdem.C3, holdem.D), holdem.ToCard(holdem.C4, holdem.C),
+	)
+
+	var omaha Omaha
+	if _, qualifies := omaha.EvaluateLow(hole, board); qualifies {
+		t.Error("expected no legal Omaha low: the board alone has only one qualifying rank")
+	}
+
+	if _, qualifies := holdem.LowScore(hole.Combine(board)); !qualifies {
+		t.Fatal("test setup invalid: expected the full 7 cards to contain a low, to show why the 2+3 rule matters")
+	}
+}
+
+func TestShortDeckFlushBeatsFullHouse(t *testing.T) {
+	flush := holdem.CreateHand(
+		holdem.ToCard(holdem.A, holdem.S), holdem.ToCard(holdem.K, holdem.S),
+		holdem.ToCard(holdem.Q, holdem.S), holdem.ToCard(holdem.J, holdem.S),
+		holdem.ToCard(holdem.C9, holdem.S),
+	)
+	fullHouse := holdem.CreateHand(
+		holdem.ToCard(holdem.K, holdem.H), holdem.ToCard(holdem.K, holdem.D),
+		holdem.ToCard(holdem.K, holdem.C), holdem.ToCard(holdem.Q, holdem.H),
+		holdem.ToCard(holdem.Q, holdem.D),
+	)
+
+	if shortDeckScore(flush) <= shortDeckScore(fullHouse) {
+		t.Errorf("expected a flush to outrank a full house in short deck")
+	}
+}
+
+func TestSplitShowdownHiLo(t *testing.T) {
+	board := holdem.CreateHand(
+		holdem.ToCard(holdem.C2, holdem.H), holdem.ToCard(holdem.C3, holdem.D),
+		holdem.ToCard(holdem.C4, holdem.C), holdem.ToCard(holdem.C9, holdem.S),
+		holdem.ToCard(holdem.K, holdem.D),
+	)
+	// Player 1 qualifies for a low with A-2-3-4-6 (no 5 on board, so
+	// no wheel straight), but has only a high card for the high half.
+	player1 := holdem.CreateHand(holdem.ToCard(holdem.A, holdem.S), holdem.ToCard(holdem.C6, holdem.H))
+	// Player 2 makes a set of kings for the best high, but no low.
+	player2 := holdem.CreateHand(holdem.ToCard(holdem.K, holdem.S), holdem.ToCard(holdem.K, holdem.C))
+
+	result := SplitShowdown([]holdem.Hand{player1, player2}, board, TexasHoldem{})
+
+	if len(result.High) != 1 || result.High[0] != 1 {
+		t.Errorf("expected player 2 to win the high half, got %v", result.High)
+	}
+	if len(result.Low) != 1 || result.Low[0] != 0 {
+		t.Errorf("expected player 1 to win the low half, got %v", result.Low)
+	}
+}
+
+func TestSplitShowdownNoQualifyingLowScoops(t *testing.T) {
+	board := holdem.CreateHand(
+		holdem.ToCard(holdem.J, holdem.H), holdem.ToCard(holdem.Q, holdem.D),
+		holdem.ToCard(holdem.K, holdem.C), holdem.ToCard(holdem.C9, holdem.S),
+		holdem.ToCard(holdem.K, holdem.D),
+	)
+	player1 := holdem.CreateHand(holdem.ToCard(holdem.A, holdem.S), holdem.ToCard(holdem.C2, holdem.H))
+	player2 := holdem.CreateHand(holdem.ToCard(holdem.C4, holdem.S), holdem.ToCard(holdem.C5, holdem.C))
+
+	result := SplitShowdown([]holdem.Hand{player1, player2}, board, TexasHoldem{})
+
+	if len(result.Low) != 0 {
+		t.Errorf("expected no low winners, got %v", result.Low)
+	}
+	if len(result.High) == 0 {
+		t.Error("expected a high winner to scoop")
+	}
+}
+
+func TestShortDeckWheelStraight(t *testing.T) {
+	wheel := holdem.CreateHand(
+		holdem.ToCard(holdem.A, holdem.S), holdem.ToCard(holdem.C6, holdem.H),
+		holdem.ToCard(holdem.C7, holdem.D), holdem.ToCard(holdem.C8, holdem.C),
+		holdem.ToCard(holdem.C9, holdem.S),
+	)
+	sixToTen := holdem.CreateHand(
+		holdem.ToCard(holdem.C6, holdem.S), holdem.ToCard(holdem.C7, holdem.H),
+		holdem.ToCard(holdem.C8, holdem.D), holdem.ToCard(holdem.C9, holdem.C),
+		holdem.ToCard(holdem.C10, holdem.S),
+	)
+
+	if shortDeckScore(wheel) >= shortDeckScore(sixToTen) {
+		t.Errorf("expected A-6-7-8-9 to be the lowest straight in short deck")
+	}
+}
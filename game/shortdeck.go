@@ -0,0 +1,112 @@
+package game
+
+import "github.com/felixwatts/holdem"
+
+// ShortDeck, also known as 6+ Hold'em, is played with the 2s through
+// 5s removed from the deck (see NewShortDeck). With fewer low cards,
+// flushes are harder to make than full houses, so a flush outranks a
+// full house here, unlike in standard Hold'em. An ace may also
+// complete the lowest straight, A-6-7-8-9, standing in for the
+// missing 2-3-4-5.
+type ShortDeck struct{}
+
+func (ShortDeck) HoleCards() int  { return 2 }
+func (ShortDeck) BoardCards() int { return 5 }
+
+func (ShortDeck) Evaluate(hole, board holdem.Hand) uint64 {
+	cards := hole.Combine(board).Cards()
+	n := len(cards)
+
+	var best uint64
+	first := true
+	for mask := uint64(1)<<5 - 1; mask < uint64(1)<<uint(n); {
+		sub := holdem.Hand(0)
+		for i := 0; i < n; i++ {
+			if mask&(1<<uint(i)) != 0 {
+				sub = sub.AddCard(cards[i])
+			}
+		}
+		if s := shortDeckScore(sub); first || s > best {
+			best = s
+			first = false
+		}
+
+		lowBit := mask & -mask
+		next := mask + lowBit
+		mask = next + (((next ^ mask) / lowBit) >> 2)
+	}
+
+	return best
+}
+
+// EvaluateLow always reports no qualifying low. With 2 through 5
+// stripped from the deck, only A, 6, 7 and 8 are low enough to
+// qualify, so the 5 distinct low ranks an 8-or-better low requires
+// can never be dealt.
+func (ShortDeck) EvaluateLow(hole, board holdem.Hand) (uint32, bool) {
+	return 0, false
+}
+
+// RankOrder returns 6 through ace, the faces left in a short deck.
+func (ShortDeck) RankOrder() []holdem.Face {
+	return standardRankOrder[holdem.C6:]
+}
+
+// shortDeckScore scores a 5-card hand under short deck rules. It
+// scores via holdem.Hand.Score and then swaps the flush and full
+// house categories, which holdem.Score otherwise ranks in standard
+// order, and special cases the A-6-7-8-9 straight that standard
+// scoring can't see because its faces aren't numerically adjacent.
+func shortDeckScore(h holdem.Hand) uint64 {
+	if isStraight, isFlush := shortDeckWheel(h); isStraight {
+		if isFlush {
+			return pack(8, holdem.C9)
+		}
+		return pack(4, holdem.C9)
+	}
+
+	score := h.Score()
+	switch score >> 20 {
+	case 5: // flush outranks full house in short deck
+		return score&0xFFFFF | (6 << 20)
+	case 6: // full house ranks below flush in short deck
+		return score&0xFFFFF | (5 << 20)
+	}
+	return score
+}
+
+// shortDeckWheel reports whether the 5-card hand h is the A-6-7-8-9
+// straight, the lowest straight possible once 2-5 are stripped from
+// the deck, and if so whether it's also a straight flush.
+func shortDeckWheel(h holdem.Hand) (isStraight bool, isFlush bool) {
+	wheelFaces := []holdem.Face{holdem.A, holdem.C6, holdem.C7, holdem.C8, holdem.C9}
+
+	fc := holdem.FaceCounts(h)
+	for _, f := range wheelFaces {
+		if fc[f] == 0 {
+			return false, false
+		}
+	}
+
+	for s := holdem.Suit(0); s < 4; s++ {
+		suited := true
+		for _, f := range wheelFaces {
+			if !h.HasCard(holdem.ToCard(f, s)) {
+				suited = false
+				break
+			}
+		}
+		if suited {
+			return true, true
+		}
+	}
+
+	return true, false
+}
+
+// pack encodes a hand category and its single primary rank into a
+// score with the same bit layout as holdem.Hand.Score, so short deck
+// scores compare correctly against each other.
+func pack(category uint8, face holdem.Face) uint64 {
+	return uint64(category)<<20 | (uint64(face)+1)<<16
+}
@@ -0,0 +1,24 @@
+package game
+
+import "github.com/felixwatts/holdem"
+
+// Showdown evaluates each player's hole cards against the board
+// under v's rules and returns the indices of the winning player(s).
+// More than one index is returned when the pot is split.
+func Showdown(players []holdem.Hand, board holdem.Hand, v Variant) []int {
+	var winners []int
+	var best uint64
+
+	for i, hole := range players {
+		score := v.Evaluate(hole, board)
+		switch {
+		case len(winners) == 0 || score > best:
+			best = score
+			winners = []int{i}
+		case score == best:
+			winners = append(winners, i)
+		}
+	}
+
+	return winners
+}
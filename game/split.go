@@ -0,0 +1,37 @@
+package game
+
+import "github.com/felixwatts/holdem"
+
+// SplitResult holds the high and low winners of a hi/lo split-pot
+// showdown. Low is empty when no player's hand qualifies for a low,
+// in which case High scoops the entire pot.
+type SplitResult struct {
+	High []int
+	Low  []int
+}
+
+// SplitShowdown evaluates each player's hole cards against the board
+// under v's rules for both the high hand and an eight-or-better
+// ace-to-five low hand, and returns the winning player indices for
+// each half of the pot.
+func SplitShowdown(players []holdem.Hand, board holdem.Hand, v Variant) SplitResult {
+	high := Showdown(players, board, v)
+
+	var low []int
+	var bestLow uint32
+	for i, hole := range players {
+		score, qualifies := v.EvaluateLow(hole, board)
+		if !qualifies {
+			continue
+		}
+		switch {
+		case len(low) == 0 || score < bestLow:
+			bestLow = score
+			low = []int{i}
+		case score == bestLow:
+			low = append(low, i)
+		}
+	}
+
+	return SplitResult{High: high, Low: low}
+}
@@ -0,0 +1,135 @@
+// Package game builds full poker game variants on top of the Hand
+// and Card primitives in the holdem package: how many hole and board
+// cards each variant deals, how a showdown is scored, and who wins.
+package game
+
+import "github.com/felixwatts/holdem"
+
+// Variant describes the rules of a poker game: how many private hole
+// cards each player gets, how many shared board cards are dealt, how
+// a player's hole cards and the board combine into a score, and the
+// face rank order used by the game.
+type Variant interface {
+	// HoleCards returns the number of private cards dealt to each player.
+	HoleCards() int
+	// BoardCards returns the number of shared community cards dealt.
+	BoardCards() int
+	// Evaluate returns the score of the best hand a player can make
+	// from hole combined with board, under this variant's rules.
+	// Higher scores win, as with holdem.Hand.Score.
+	Evaluate(hole, board holdem.Hand) uint64
+	// EvaluateLow returns the best ace-to-five low hand a player can
+	// make from hole combined with board, under this variant's rules,
+	// and whether it qualifies (see holdem.LowScore). Lower scores
+	// win, and are only comparable among hands that qualify.
+	EvaluateLow(hole, board holdem.Hand) (score uint32, qualifies bool)
+	// RankOrder returns this variant's faces in ascending rank order,
+	// lowest first.
+	RankOrder() []holdem.Face
+}
+
+// standardRankOrder is the face rank order of the full-deck variants
+// in this package: 2 is lowest, ace is highest.
+var standardRankOrder = []holdem.Face{
+	holdem.C2, holdem.C3, holdem.C4, holdem.C5, holdem.C6, holdem.C7,
+	holdem.C8, holdem.C9, holdem.C10, holdem.J, holdem.Q, holdem.K, holdem.A,
+}
+
+// TexasHoldem is the standard two hole card, five board card variant,
+// where a player's hand is the best 5 cards from their 2 hole cards
+// and the 5 card board.
+type TexasHoldem struct{}
+
+func (TexasHoldem) HoleCards() int  { return 2 }
+func (TexasHoldem) BoardCards() int { return 5 }
+
+func (TexasHoldem) Evaluate(hole, board holdem.Hand) uint64 {
+	_, score := hole.Combine(board).BestFive()
+	return score
+}
+
+func (TexasHoldem) EvaluateLow(hole, board holdem.Hand) (uint32, bool) {
+	return holdem.LowScore(hole.Combine(board))
+}
+
+func (TexasHoldem) RankOrder() []holdem.Face {
+	return standardRankOrder
+}
+
+// Omaha deals four hole cards per player. Unlike Texas Hold'em, a
+// hand must use exactly two of those hole cards together with
+// exactly three of the five board cards - a player can't play the
+// board or use only one hole card.
+type Omaha struct{}
+
+func (Omaha) HoleCards() int  { return 4 }
+func (Omaha) BoardCards() int { return 5 }
+
+func (Omaha) Evaluate(hole, board holdem.Hand) uint64 {
+	var best uint64
+	for _, five := range omahaHands(hole, board) {
+		if s := five.Score(); s > best {
+			best = s
+		}
+	}
+	return best
+}
+
+func (Omaha) EvaluateLow(hole, board holdem.Hand) (score uint32, qualifies bool) {
+	for _, five := range omahaHands(hole, board) {
+		s, ok := holdem.LowScore(five)
+		if ok && (!qualifies || s < score) {
+			score = s
+			qualifies = true
+		}
+	}
+	return score, qualifies
+}
+
+func (Omaha) RankOrder() []holdem.Face {
+	return standardRankOrder
+}
+
+// omahaHands returns every legal Omaha 5-card hand: exactly 2 of the
+// 4 hole cards together with exactly 3 of the 5 board cards. Both
+// Evaluate and EvaluateLow score their best candidate out of this
+// same legal set.
+func omahaHands(hole, board holdem.Hand) []holdem.Hand {
+	holeCards := hole.Cards()
+	boardCards := board.Cards()
+
+	var hands []holdem.Hand
+	for i := 0; i < len(holeCards); i++ {
+		for j := i + 1; j < len(holeCards); j++ {
+			for a := 0; a < len(boardCards); a++ {
+				for b := a + 1; b < len(boardCards); b++ {
+					for d := b + 1; d < len(boardCards); d++ {
+						hands = append(hands, holdem.CreateHand(holeCards[i], holeCards[j], boardCards[a], boardCards[b], boardCards[d]))
+					}
+				}
+			}
+		}
+	}
+	return hands
+}
+
+// SevenCardStud deals seven private cards per player and has no
+// shared board. Only 5 of the 7 cards count, so the best 5-card hand
+// from all 7 is taken exactly as in Texas Hold'em.
+type SevenCardStud struct{}
+
+func (SevenCardStud) HoleCards() int  { return 7 }
+func (SevenCardStud) BoardCards() int { return 0 }
+
+func (SevenCardStud) Evaluate(hole, board holdem.Hand) uint64 {
+	_, score := hole.Combine(board).BestFive()
+	return score
+}
+
+func (SevenCardStud) EvaluateLow(hole, board holdem.Hand) (uint32, bool) {
+	return holdem.LowScore(hole.Combine(board))
+}
+
+func (SevenCardStud) RankOrder() []holdem.Face {
+	return standardRankOrder
+}
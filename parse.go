@@ -0,0 +1,293 @@
+package holdem
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseCard parses a card in standard two-character poker notation,
+// such as "As", "Td" or "2c": a rank from "23456789TJQKA" followed by
+// a suit from "shdc", case insensitive.
+func ParseCard(s string) (Card, error) {
+	if len(s) != 2 {
+		return 0, fmt.Errorf("holdem: invalid card %q: expected 2 characters", s)
+	}
+
+	f, err := parseFace(s[0])
+	if err != nil {
+		return 0, fmt.Errorf("holdem: invalid card %q: %w", s, err)
+	}
+	suit, err := parseSuit(s[1])
+	if err != nil {
+		return 0, fmt.Errorf("holdem: invalid card %q: %w", s, err)
+	}
+
+	return ToCard(f, suit), nil
+}
+
+// ParseHand parses a hand of one or more cards in standard notation,
+// separated by spaces, commas, or nothing at all: "As Kd 2c",
+// "As, Kd, 2c" and "AsKd2c" are all equivalent.
+func ParseHand(s string) (Hand, error) {
+	s = strings.NewReplacer(" ", "", ",", "").Replace(s)
+	if len(s)%2 != 0 {
+		return 0, fmt.Errorf("holdem: invalid hand %q: expected a whole number of 2-character cards", s)
+	}
+
+	h := Hand(0)
+	for i := 0; i < len(s); i += 2 {
+		c, err := ParseCard(s[i : i+2])
+		if err != nil {
+			return 0, err
+		}
+		h = h.AddCard(c)
+	}
+
+	return h, nil
+}
+
+// ParseRange parses a comma separated list of starting hand ranges in
+// standard shorthand notation and returns the full set of concrete
+// two-card hands they represent. Supported notations are a pair
+// ("77"), a suited or offsuit hand ("AKs", "AKo"), a "+" to extend a
+// hand up to the top of its suit/rank ("77+", "ATs+"), and a "-" to
+// span a contiguous range between two hands of the same shape
+// ("99-55", "T9s-76s"). This is the building block for pre-flop range
+// analysis, where a range is expressed as a set of starting hands
+// rather than one concrete hand.
+func ParseRange(s string) ([]Hand, error) {
+	var hands []Hand
+
+	for _, tok := range strings.Split(s, ",") {
+		tok = strings.TrimSpace(tok)
+		if tok == "" {
+			continue
+		}
+
+		expanded, err := expandRangeToken(tok)
+		if err != nil {
+			return nil, err
+		}
+		hands = append(hands, expanded...)
+	}
+
+	return hands, nil
+}
+
+func expandRangeToken(tok string) ([]Hand, error) {
+	switch {
+	case strings.HasSuffix(tok, "+"):
+		return expandPlusToken(tok[:len(tok)-1])
+	case strings.Contains(tok, "-"):
+		bounds := strings.SplitN(tok, "-", 2)
+		return expandRangeSpan(bounds[0], bounds[1])
+	default:
+		shape, err := parseHandShape(tok)
+		if err != nil {
+			return nil, err
+		}
+		return shape.concreteHands(), nil
+	}
+}
+
+// expandPlusToken expands a "+" suffixed hand, such as "77+" or
+// "ATs+", to every hand shape from it up to the top of its range: all
+// higher pairs for a pair, or all hands with the same high card and a
+// low card from the given one up to one below the high card.
+func expandPlusToken(base string) ([]Hand, error) {
+	shape, err := parseHandShape(base)
+	if err != nil {
+		return nil, err
+	}
+
+	var hands []Hand
+	if shape.pair {
+		for f := shape.lo; f <= A; f++ {
+			hands = append(hands, handShape{hi: f, lo: f, pair: true}.concreteHands()...)
+		}
+		return hands, nil
+	}
+
+	for lo := shape.lo; lo < shape.hi; lo++ {
+		hands = append(hands, handShape{hi: shape.hi, lo: lo, suited: shape.suited}.concreteHands()...)
+	}
+	return hands, nil
+}
+
+// expandRangeSpan expands a "-" separated pair of hands of the same
+// shape, such as "99-55" or "T9s-76s", to every hand shape between the
+// two endpoints inclusive.
+func expandRangeSpan(from, to string) ([]Hand, error) {
+	a, err := parseHandShape(from)
+	if err != nil {
+		return nil, err
+	}
+	b, err := parseHandShape(to)
+	if err != nil {
+		return nil, err
+	}
+	if a.pair != b.pair || a.suited != b.suited {
+		return nil, fmt.Errorf("holdem: invalid range %q-%q: endpoints must be the same shape", from, to)
+	}
+
+	var hands []Hand
+	if a.pair {
+		lo, hi := orderFaces(a.lo, b.lo)
+		for f := lo; f <= hi; f++ {
+			hands = append(hands, handShape{hi: f, lo: f, pair: true}.concreteHands()...)
+		}
+		return hands, nil
+	}
+
+	gap := int(a.hi) - int(a.lo)
+	if gap != int(b.hi)-int(b.lo) {
+		return nil, fmt.Errorf("holdem: invalid range %q-%q: endpoints must have the same gap between ranks", from, to)
+	}
+
+	lo, hi := orderFaces(a.lo, b.lo)
+	for f := lo; f <= hi; f++ {
+		hands = append(hands, handShape{hi: f + Face(gap), lo: f, suited: a.suited}.concreteHands()...)
+	}
+	return hands, nil
+}
+
+// handShape is a starting hand shorthand such as "AKs" or "77": a
+// pair of ranks plus, for non-pairs, whether the hand is suited.
+type handShape struct {
+	hi, lo Face
+	suited bool
+	pair   bool
+}
+
+// parseHandShape parses a single hand shorthand, such as "77", "AKs"
+// or "AKo", with no "+" or "-" modifier.
+func parseHandShape(tok string) (handShape, error) {
+	switch len(tok) {
+	case 2:
+		f1, err := parseFace(tok[0])
+		if err != nil {
+			return handShape{}, fmt.Errorf("holdem: invalid hand %q: %w", tok, err)
+		}
+		f2, err := parseFace(tok[1])
+		if err != nil {
+			return handShape{}, fmt.Errorf("holdem: invalid hand %q: %w", tok, err)
+		}
+		if f1 != f2 {
+			return handShape{}, fmt.Errorf("holdem: invalid hand %q: specify s or o for non-pairs", tok)
+		}
+		return handShape{hi: f1, lo: f1, pair: true}, nil
+	case 3:
+		f1, err := parseFace(tok[0])
+		if err != nil {
+			return handShape{}, fmt.Errorf("holdem: invalid hand %q: %w", tok, err)
+		}
+		f2, err := parseFace(tok[1])
+		if err != nil {
+			return handShape{}, fmt.Errorf("holdem: invalid hand %q: %w", tok, err)
+		}
+		if f1 == f2 {
+			return handShape{}, fmt.Errorf("holdem: invalid hand %q: a pair can't be suited or offsuit", tok)
+		}
+		var suited bool
+		switch tok[2] {
+		case 's', 'S':
+			suited = true
+		case 'o', 'O':
+			suited = false
+		default:
+			return handShape{}, fmt.Errorf("holdem: invalid hand %q: expected s or o, got %q", tok, tok[2:])
+		}
+		lo, hi := orderFaces(f1, f2)
+		return handShape{hi: hi, lo: lo, suited: suited}, nil
+	default:
+		return handShape{}, fmt.Errorf("holdem: invalid hand %q: expected 2 or 3 characters", tok)
+	}
+}
+
+// concreteHands returns every concrete 2-card Hand matching this
+// shape: 6 combinations for a pair, 4 for a suited hand, 12 for an
+// offsuit hand.
+func (shape handShape) concreteHands() []Hand {
+	var hands []Hand
+
+	if shape.pair {
+		for s1 := Suit(0); s1 < 4; s1++ {
+			for s2 := s1 + 1; s2 < 4; s2++ {
+				hands = append(hands, CreateHand(ToCard(shape.hi, s1), ToCard(shape.hi, s2)))
+			}
+		}
+		return hands
+	}
+
+	if shape.suited {
+		for s := Suit(0); s < 4; s++ {
+			hands = append(hands, CreateHand(ToCard(shape.hi, s), ToCard(shape.lo, s)))
+		}
+		return hands
+	}
+
+	for s1 := Suit(0); s1 < 4; s1++ {
+		for s2 := Suit(0); s2 < 4; s2++ {
+			if s1 != s2 {
+				hands = append(hands, CreateHand(ToCard(shape.hi, s1), ToCard(shape.lo, s2)))
+			}
+		}
+	}
+	return hands
+}
+
+// orderFaces returns f1 and f2 sorted into (lo, hi) order.
+func orderFaces(f1, f2 Face) (lo, hi Face) {
+	if f1 < f2 {
+		return f1, f2
+	}
+	return f2, f1
+}
+
+func parseFace(b byte) (Face, error) {
+	switch b {
+	case '2':
+		return C2, nil
+	case '3':
+		return C3, nil
+	case '4':
+		return C4, nil
+	case '5':
+		return C5, nil
+	case '6':
+		return C6, nil
+	case '7':
+		return C7, nil
+	case '8':
+		return C8, nil
+	case '9':
+		return C9, nil
+	case 'T', 't':
+		return C10, nil
+	case 'J', 'j':
+		return J, nil
+	case 'Q', 'q':
+		return Q, nil
+	case 'K', 'k':
+		return K, nil
+	case 'A', 'a':
+		return A, nil
+	default:
+		return 0, fmt.Errorf("invalid rank %q", string(b))
+	}
+}
+
+func parseSuit(b byte) (Suit, error) {
+	switch b {
+	case 's', 'S':
+		return S, nil
+	case 'h', 'H':
+		return H, nil
+	case 'd', 'D':
+		return D, nil
+	case 'c', 'C':
+		return C, nil
+	default:
+		return 0, fmt.Errorf("invalid suit %q", string(b))
+	}
+}
@@ -0,0 +1,144 @@
+package holdem
+
+import (
+	"testing"
+)
+
+func TestParseCard(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Card
+	}{
+		{"As", ToCard(A, S)},
+		{"Td", ToCard(C10, D)},
+		{"2c", ToCard(C2, C)},
+		{"kh", ToCard(K, H)},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseCard(tt.in)
+		if err != nil {
+			t.Errorf("ParseCard(%q): unexpected error: %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseCard(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseCardInvalid(t *testing.T) {
+	for _, in := range []string{"", "A", "Asx", "Xs", "Az"} {
+		if _, err := ParseCard(in); err == nil {
+			t.Errorf("ParseCard(%q): expected an error", in)
+		}
+	}
+}
+
+func TestParseHand(t *testing.T) {
+	want := CreateHand(ToCard(A, S), ToCard(K, D), ToCard(C2, C))
+
+	for _, in := range []string{"As Kd 2c", "As, Kd, 2c", "AsKd2c"} {
+		got, err := ParseHand(in)
+		if err != nil {
+			t.Errorf("ParseHand(%q): unexpected error: %v", in, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("ParseHand(%q) = %s, want %s", in, got, want)
+		}
+	}
+}
+
+func TestParseHandInvalid(t *testing.T) {
+	if _, err := ParseHand("AsK"); err == nil {
+		t.Error("expected an error for an odd number of characters")
+	}
+}
+
+func TestParseRangePair(t *testing.T) {
+	hands, err := ParseRange("77")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(hands) != 6 {
+		t.Fatalf("expected 6 combinations of 77, got %d", len(hands))
+	}
+	for _, h := range hands {
+		if h.NumCards() != 2 {
+			t.Errorf("expected a 2 card hand, got %d cards", h.NumCards())
+		}
+	}
+}
+
+func TestParseRangeSuitedAndOffsuit(t *testing.T) {
+	suited, err := ParseRange("AKs")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(suited) != 4 {
+		t.Errorf("expected 4 combinations of AKs, got %d", len(suited))
+	}
+
+	offsuit, err := ParseRange("AKo")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(offsuit) != 12 {
+		t.Errorf("expected 12 combinations of AKo, got %d", len(offsuit))
+	}
+}
+
+func TestParseRangePlus(t *testing.T) {
+	hands, err := ParseRange("77+")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// 77, 88, 99, TT, JJ, QQ, KK, AA: 8 ranks at 6 combos each.
+	if len(hands) != 8*6 {
+		t.Errorf("expected %d hands for 77+, got %d", 8*6, len(hands))
+	}
+}
+
+func TestParseRangePlusNonPair(t *testing.T) {
+	hands, err := ParseRange("ATs+")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// ATs, AJs, AQs, AKs: 4 ranks at 4 combos each.
+	if len(hands) != 4*4 {
+		t.Errorf("expected %d hands for ATs+, got %d", 4*4, len(hands))
+	}
+	for _, h := range hands {
+		if !h.HasCard(ToCard(A, S)) && !h.HasCard(ToCard(A, H)) && !h.HasCard(ToCard(A, D)) && !h.HasCard(ToCard(A, C)) {
+			t.Errorf("expected every hand in ATs+ to contain an ace, got %s", h)
+		}
+	}
+}
+
+func TestParseRangeSpan(t *testing.T) {
+	hands, err := ParseRange("T9s-76s")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// T9s, 98s, 87s, 76s: 4 ranks at 4 combos each.
+	if len(hands) != 4*4 {
+		t.Errorf("expected %d hands for T9s-76s, got %d", 4*4, len(hands))
+	}
+}
+
+func TestParseRangeSpanMismatchedShapeErrors(t *testing.T) {
+	if _, err := ParseRange("T9s-76o"); err == nil {
+		t.Error("expected an error mixing suited and offsuit endpoints")
+	}
+}
+
+func TestParseRangeCommaSeparated(t *testing.T) {
+	hands, err := ParseRange("AA, AKs")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(hands) != 6+4 {
+		t.Errorf("expected %d hands, got %d", 6+4, len(hands))
+	}
+}